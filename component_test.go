@@ -0,0 +1,74 @@
+package jot
+
+import "testing"
+
+func TestSetEnabledPatternGlobMatching(t *testing.T) {
+	defer SetEnabledPattern("")
+
+	tests := []struct {
+		name      string
+		pattern   string
+		component string
+		want      bool
+	}{
+		{"wildcard matches an immediate child", "server:*", "server:http", true},
+		{"wildcard also matches a deeper descendant", "server:*", "server:http:low", true},
+		{"wildcard requires the colon prefix", "server:*", "server", false},
+		{"unrelated component stays disabled", "server:*", "worker", false},
+		{"exact pattern matches itself", "server", "server", true},
+		{"exact pattern does not match a child", "server", "server:http", false},
+		{"first pattern of a multi-pattern list matches", "a:*,b:*", "a:x", true},
+		{"second pattern of a multi-pattern list matches", "a:*,b:*", "b:y", true},
+		{"neither pattern of a multi-pattern list matches", "a:*,b:*", "c:z", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetEnabledPattern(tt.pattern)
+			if got := componentEnabled(tt.component); got != tt.want {
+				t.Errorf("componentEnabled(%q) with pattern %q = %v, want %v", tt.component, tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetEnabledComponentsIsExactMatchOnly(t *testing.T) {
+	defer SetEnabledPattern("")
+
+	SetEnabledComponents("server", "worker")
+
+	tests := []struct {
+		component string
+		want      bool
+	}{
+		{"server", true},
+		{"worker", true},
+		{"server:http", false},
+		{"other", false},
+	}
+	for _, tt := range tests {
+		if got := componentEnabled(tt.component); got != tt.want {
+			t.Errorf("componentEnabled(%q) = %v, want %v", tt.component, got, tt.want)
+		}
+	}
+}
+
+func TestEmptyComponentIsAlwaysEnabled(t *testing.T) {
+	defer SetEnabledPattern("")
+
+	SetEnabledPattern("server:*")
+	if !componentEnabled("") {
+		t.Error("an unnamed component must stay enabled regardless of the filter")
+	}
+}
+
+func TestSetEnabledPatternEmptyStringReenablesEverything(t *testing.T) {
+	defer SetEnabledPattern("")
+
+	SetEnabledPattern("server:*")
+	SetEnabledPattern("")
+
+	if !componentEnabled("worker") {
+		t.Error("an empty pattern should re-enable every component")
+	}
+}
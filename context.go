@@ -0,0 +1,119 @@
+package jot
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// contextKey is the context.Context key under which a *Jotter is stored by
+// NewContext.
+type contextKey struct{}
+
+// contextAttrFuncs holds the current []func(context.Context) []interface{}
+// registered via RegisterContextAttrFunc. It is read on every *Context
+// call, so it is stored behind an atomic.Value - the same swap-on-write
+// pattern SetEnabledPattern uses for component filtering - rather than a
+// plain package slice, so registration is safe even once logging is
+// already underway.
+var contextAttrFuncs atomic.Value // []func(context.Context) []interface{}
+
+// contextAttrFuncsMu serializes writers (RegisterContextAttrFunc); readers
+// go through contextAttrFuncs.Load and never block on it.
+var contextAttrFuncsMu sync.Mutex
+
+func init() {
+	contextAttrFuncs.Store([]func(context.Context) []interface{}(nil))
+}
+
+// DefaultContextAttrFuncs returns the context attribute funcs currently
+// registered via RegisterContextAttrFunc, in registration order.
+func DefaultContextAttrFuncs() []func(context.Context) []interface{} {
+	return contextAttrFuncs.Load().([]func(context.Context) []interface{})
+}
+
+// RegisterContextAttrFunc adds fn to the funcs invoked by every *Context
+// call to pull structured fields out of a context.Context - trace IDs,
+// user IDs, correlation IDs and the like - so every jotted call in a
+// request's path carries them automatically. Safe to call concurrently
+// with other registrations and with logging already underway.
+func RegisterContextAttrFunc(fn func(context.Context) []interface{}) {
+	contextAttrFuncsMu.Lock()
+	defer contextAttrFuncsMu.Unlock()
+
+	old := DefaultContextAttrFuncs()
+	next := make([]func(context.Context) []interface{}, len(old)+1)
+	copy(next, old)
+	next[len(old)] = fn
+	contextAttrFuncs.Store(next)
+}
+
+// NewContext returns a copy of ctx carrying j, for later retrieval with
+// FromContext. This lets a Jotter with fields bound via With be threaded
+// through a call chain without every function needing its own parameter.
+func NewContext(ctx context.Context, j *Jotter) context.Context {
+	return context.WithValue(ctx, contextKey{}, j)
+}
+
+// FromContext returns the Jotter stored in ctx by NewContext, or the
+// standard Jotter if ctx carries none.
+func FromContext(ctx context.Context) *Jotter {
+	if j, ok := ctx.Value(contextKey{}).(*Jotter); ok {
+		return j
+	}
+	return &jotter
+}
+
+// contextFields runs every func registered via RegisterContextAttrFunc
+// against ctx and concatenates the results into a single list of field
+// pairs.
+func contextFields(ctx context.Context) []interface{} {
+	var fields []interface{}
+	for _, fn := range DefaultContextAttrFuncs() {
+		fields = append(fields, fn(ctx)...)
+	}
+	return fields
+}
+
+// PrintContext writes v at LevelInfo, extended with fields pulled from ctx
+// via DefaultContextAttrFuncs.
+// Arguments are handled in the manner of fmt.Print.
+func (j *Jotter) PrintContext(ctx context.Context, v ...interface{}) {
+	j.emit(LevelInfo, fmt.Sprint(v...), contextFields(ctx)...)
+}
+
+// PrintfContext writes v at LevelInfo, extended with fields pulled from ctx
+// via DefaultContextAttrFuncs.
+// Arguments are handled in the manner of fmt.Printf.
+func (j *Jotter) PrintfContext(ctx context.Context, format string, v ...interface{}) {
+	j.emit(LevelInfo, fmt.Sprintf(format, v...), contextFields(ctx)...)
+}
+
+// PrintwContext writes a structured event at LevelInfo: msg plus keyvals,
+// extended with fields pulled from ctx via DefaultContextAttrFuncs and any
+// fields bound by With.
+func (j *Jotter) PrintwContext(ctx context.Context, msg string, keyvals ...interface{}) {
+	fields := append(contextFields(ctx), toFieldPairs(keyvals)...)
+	j.emit(LevelInfo, msg, fields...)
+}
+
+// PrintContext writes v via the Jotter stored in ctx (see NewContext),
+// falling back to the standard Jotter.
+// Arguments are handled in the manner of fmt.Print.
+func PrintContext(ctx context.Context, v ...interface{}) {
+	FromContext(ctx).PrintContext(ctx, v...)
+}
+
+// PrintfContext writes v via the Jotter stored in ctx (see NewContext),
+// falling back to the standard Jotter.
+// Arguments are handled in the manner of fmt.Printf.
+func PrintfContext(ctx context.Context, format string, v ...interface{}) {
+	FromContext(ctx).PrintfContext(ctx, format, v...)
+}
+
+// PrintwContext writes a structured event via the Jotter stored in ctx (see
+// NewContext), falling back to the standard Jotter.
+func PrintwContext(ctx context.Context, msg string, keyvals ...interface{}) {
+	FromContext(ctx).PrintwContext(ctx, msg, keyvals...)
+}
@@ -0,0 +1,86 @@
+package jot
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+type traceIDKey struct{}
+
+func TestRegisteredContextAttrFuncFieldsAppearOnEmittedLine(t *testing.T) {
+	defer resetContextAttrFuncs()
+
+	RegisterContextAttrFunc(func(ctx context.Context) []interface{} {
+		id, _ := ctx.Value(traceIDKey{}).(string)
+		return []interface{}{"traceID", id}
+	})
+
+	c := &captorPrinter{}
+	j := New(c)
+	j.Enable()
+	ctx := context.WithValue(context.Background(), traceIDKey{}, "abc-123")
+
+	j.PrintwContext(ctx, "handling request")
+
+	if len(c.lines) != 1 {
+		t.Fatalf("got %d lines, want 1", len(c.lines))
+	}
+	if !strings.Contains(c.lines[0], "traceID=abc-123") {
+		t.Errorf("line = %q, want it to contain %q", c.lines[0], "traceID=abc-123")
+	}
+}
+
+func TestPrintwContextMergesContextAndWithFieldsInOrder(t *testing.T) {
+	defer resetContextAttrFuncs()
+
+	RegisterContextAttrFunc(func(ctx context.Context) []interface{} {
+		return []interface{}{"fromCtx", "ctx-value"}
+	})
+
+	c := &captorPrinter{}
+	j := New(c).With("fromWith", "with-value")
+	j.Enable()
+
+	j.PrintwContext(context.Background(), "event", "fromKeyvals", "keyvals-value")
+
+	if len(c.lines) != 1 {
+		t.Fatalf("got %d lines, want 1", len(c.lines))
+	}
+	line := c.lines[0]
+	withIdx := strings.Index(line, "fromWith=with-value")
+	ctxIdx := strings.Index(line, "fromCtx=ctx-value")
+	keyvalsIdx := strings.Index(line, "fromKeyvals=keyvals-value")
+	if withIdx == -1 || ctxIdx == -1 || keyvalsIdx == -1 {
+		t.Fatalf("line = %q, want all three fields present", line)
+	}
+	// emit() places the Jotter's own With-bound fields first, then whatever
+	// this call contributes - here, ctx fields followed by call-site keyvals.
+	if !(withIdx < ctxIdx && ctxIdx < keyvalsIdx) {
+		t.Errorf("line = %q, want With fields before ctx fields before call-site keyvals", line)
+	}
+}
+
+func TestFromContextFallsBackToStandardJotter(t *testing.T) {
+	if got := FromContext(context.Background()); got != &jotter {
+		t.Errorf("FromContext(no bound jotter) = %p, want the standard jotter %p", got, &jotter)
+	}
+}
+
+func TestFromContextReturnsBoundJotter(t *testing.T) {
+	c := &captorPrinter{}
+	bound := New(c)
+	ctx := NewContext(context.Background(), bound)
+
+	if got := FromContext(ctx); got != bound {
+		t.Errorf("FromContext(bound) = %p, want %p", got, bound)
+	}
+}
+
+// resetContextAttrFuncs clears funcs registered by a test so later tests
+// in the package aren't affected by it.
+func resetContextAttrFuncs() {
+	contextAttrFuncsMu.Lock()
+	defer contextAttrFuncsMu.Unlock()
+	contextAttrFuncs.Store([]func(context.Context) []interface{}(nil))
+}
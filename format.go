@@ -0,0 +1,119 @@
+package jot
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Event is a single structured log line, built from a jotted call and
+// passed to a Formatter for rendering before being handed to the
+// underlying Printer.
+type Event struct {
+	Time      time.Time
+	Level     Level
+	Msg       string
+	Component string
+	Fields    []interface{} // alternating key, value pairs
+}
+
+// Formatter renders an Event as the line that is ultimately passed to the
+// Printer.
+type Formatter interface {
+	Format(event Event) string
+}
+
+// TextFormatter renders an Event as human-readable text: `[component] `
+// when the Event has a component, then the message, followed by any fields
+// as `key=value` pairs. It is the default
+// Formatter and matches Jotter's historical, unstructured output.
+type TextFormatter struct{}
+
+// Format implements Formatter.
+func (TextFormatter) Format(event Event) string {
+	var b strings.Builder
+	if event.Component != "" {
+		fmt.Fprintf(&b, "[%s] ", event.Component)
+	}
+	b.WriteString(event.Msg)
+	for i := 0; i+1 < len(event.Fields); i += 2 {
+		fmt.Fprintf(&b, " %v=%v", event.Fields[i], fieldValue(event.Fields[i+1]))
+	}
+	return b.String()
+}
+
+// JSONFormatter renders an Event as a single line of JSON, suitable for
+// ingestion by tools such as Elastic or GCP's logging agent:
+//
+//	{"time":"...", "level":"INFO", "msg":"...", ...fields}
+type JSONFormatter struct{}
+
+// Format implements Formatter.
+func (JSONFormatter) Format(event Event) string {
+	out := make(map[string]interface{}, 3+len(event.Fields)/2)
+	out["time"] = event.Time.Format(time.RFC3339Nano)
+	out["level"] = event.Level.String()
+	out["msg"] = event.Msg
+	if event.Component != "" {
+		out["component"] = event.Component
+	}
+	for i := 0; i+1 < len(event.Fields); i += 2 {
+		out[fmt.Sprintf("%v", event.Fields[i])] = fieldValue(event.Fields[i+1])
+	}
+	line, err := json.Marshal(out)
+	if err != nil {
+		// Fall back to a minimal, always-valid line rather than dropping
+		// the event because one field didn't marshal cleanly.
+		return fmt.Sprintf(`{"time":%q,"level":%q,"msg":%q,"formatError":%q}`,
+			out["time"], out["level"], event.Msg, err.Error())
+	}
+	return string(line)
+}
+
+// fieldValue stringifies error values via Error(), so structured output
+// never carries a bare error whose own formatting may not round-trip
+// through JSON.
+func fieldValue(v interface{}) interface{} {
+	if err, ok := v.(error); ok {
+		return err.Error()
+	}
+	return v
+}
+
+// emit is the single path every jotted call funnels through: it checks the
+// level, renders the event with the configured Formatter, and forwards the
+// result to the Printer (or LevelPrinter, if the backend supports it).
+func (j *Jotter) emit(level Level, msg string, keyvals ...interface{}) {
+	if !j.V(level) {
+		return
+	}
+	fields := make([]interface{}, 0, len(j.fields)+len(keyvals))
+	fields = append(fields, j.fields...)
+	fields = append(fields, keyvals...)
+	line := j.formatter.Format(Event{
+		Time:      time.Now(),
+		Level:     level,
+		Msg:       msg,
+		Component: j.component,
+		Fields:    fields,
+	})
+	if lp, ok := j.printer.(LevelPrinter); ok {
+		lp.PrintLevel(level, line)
+		return
+	}
+	j.printer.Print(line)
+}
+
+// SetFormatter changes the Formatter used to render structured events for
+// this Jotter, e.g. switching to JSON output:
+//
+//	j.SetFormatter(jot.JSONFormatter{})
+func (j *Jotter) SetFormatter(formatter Formatter) {
+	j.formatter = formatter
+}
+
+// SetFormatter changes the Formatter used by the standard Jotter.
+func SetFormatter(formatter Formatter) {
+	jotter.SetFormatter(formatter)
+}
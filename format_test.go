@@ -0,0 +1,37 @@
+package jot
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestFieldValueStringifiesErrors(t *testing.T) {
+	if got := fieldValue(errors.New("boom")); got != "boom" {
+		t.Errorf("fieldValue(error) = %v, want %q", got, "boom")
+	}
+	if got := fieldValue(42); got != 42 {
+		t.Errorf("fieldValue(42) = %v, want 42 unchanged", got)
+	}
+}
+
+func TestJSONFormatterStringifiesErrorFields(t *testing.T) {
+	line := JSONFormatter{}.Format(Event{Msg: "hello", Fields: []interface{}{"err", errors.New("boom")}})
+	if !strings.Contains(line, `"err":"boom"`) {
+		t.Errorf("line = %q, want it to contain %q", line, `"err":"boom"`)
+	}
+}
+
+func TestJSONFormatterFallsBackOnMarshalFailure(t *testing.T) {
+	// math.Inf(1) cannot be marshalled by encoding/json, so this must take
+	// the fallback path rather than drop the event.
+	line := JSONFormatter{}.Format(Event{Msg: "broken", Fields: []interface{}{"bad", math.Inf(1)}})
+
+	if !strings.Contains(line, "formatError") {
+		t.Fatalf("line = %q, want fallback to mention formatError", line)
+	}
+	if !strings.Contains(line, "broken") {
+		t.Fatalf("line = %q, want fallback to retain the message", line)
+	}
+}
@@ -31,6 +31,88 @@ some secret API call.
 	jot.Disable()
 	jot.Print("This is not")
 
+Severity Levels
+
+Jotter also supports the usual severity levels (`LevelTrace`, `LevelDebug`,
+`LevelInfo`, `LevelWarn`, `LevelError`) via per-level methods such as `Debug`
+and `Errorf`, and the package level equivalents. `SetLevel` controls the
+minimum severity that is emitted; anything below it is skipped even while
+enabled. `Disable` still silences everything regardless of level.
+
+	jot.SetLevel(jot.LevelWarn)
+	jot.Enable()
+	jot.Debug("not printed, below the configured level")
+	jot.Warn("printed")
+
+Use `V` to guard expensive argument construction:
+
+	if jot.V(jot.LevelDebug) {
+		jot.Debug(expensiveDebugInfo())
+	}
+
+Structured Logging
+
+For structured output, use `With` to bind persistent fields to a Jotter and
+`Printw` to emit a single event with its own fields. By default fields are
+rendered as `key=value` text; call `SetFormatter(jot.JSONFormatter{})` to
+emit JSON lines instead, suitable for ingestion by Elastic or GCP:
+
+	requestLog := jot.With("requestID", id)
+	requestLog.Printw("handling request", "method", r.Method, "path", r.URL.Path)
+
+`Print`, `Printf` and `Println` remain available as before and emit an
+event with no fields.
+
+Context-Aware Jotting
+
+`PrintContext`, `PrintfContext` and `PrintwContext` accept a
+`context.Context` and extend the event with fields pulled from it via
+`RegisterContextAttrFunc`, so request-scoped values such as trace or user
+IDs are attached automatically instead of being threaded through every
+call by hand:
+
+	jot.RegisterContextAttrFunc(func(ctx context.Context) []interface{} {
+		return []interface{}{"traceID", traceIDFrom(ctx)}
+	})
+	jot.PrintwContext(ctx, "handling request")
+
+A Jotter with fields already bound via `With` can itself be carried on a
+context with `NewContext`/`FromContext`, so callers further down the chain
+pick it up without a parameter of their own.
+
+Fan-Out And Rolling File Printers
+
+`MultiPrinter` fans a single event out to several Printer destinations at
+once; `SetWriters` is a shortcut that wraps a set of `io.Writer`s in
+`log.Logger`s and combines them the same way. `FixedSizeFilePrinter` and
+`DailyFilePrinter` write to a file, rotating it by size or at local
+midnight respectively, and fall back to stderr rather than drop an event
+if a write or rotation fails:
+
+	jot.SetWriters(os.Stderr, someRemoteWriter)
+
+	jot.SetPrinter(&jot.FixedSizeFilePrinter{
+		Path:     "/var/log/app.jot",
+		MaxSize:  10 << 20,
+		MaxCount: 5,
+	})
+
+Per-Component Filtering
+
+`Named` tags a child Jotter with a component name, which is prefixed to
+text output and emitted as a "component" field in structured output.
+`SetEnabledPattern` (or the narrower `SetEnabledComponents`) then turns
+jotting on only for matching components at runtime, mirroring the
+`DEBUG=foo:*` idiom from the Node ecosystem:
+
+	jot.Enable()
+	serverLog := jot.Named("server")
+	workerLog := jot.Named("worker")
+
+	jot.SetEnabledPattern("server:*")
+	serverLog.Named("http").Info("listening") // printed
+	workerLog.Info("polling")                 // skipped
+
 A useful way to enable Jotter could be to use an environment variable. This is
 not enabled by default to prevent a generic way of enabling detailed output for
 any program that uses Jotter, but it is easy to add.
@@ -72,8 +154,9 @@ import (
 )
 
 var jotter = Jotter{
-	enabled: false,
-	printer: log.New(os.Stderr, "", log.LstdFlags),
+	enabled:   false,
+	printer:   log.New(os.Stderr, "", log.LstdFlags),
+	formatter: TextFormatter{},
 }
 
 // SetPrinter changes the Printer instance used by the standard Jotter.
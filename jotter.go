@@ -0,0 +1,61 @@
+package jot
+
+import "fmt"
+
+// Printer is implemented by anything that can emit output in the manner of
+// the standard library's log.Logger. A Jotter delegates to a Printer for
+// the actual writing, so any type exposing these three methods - including
+// *log.Logger itself - can be used as a backend.
+type Printer interface {
+	Print(v ...interface{})
+	Printf(format string, v ...interface{})
+	Println(v ...interface{})
+}
+
+// Jotter is a simple logger that can be turned on and off at runtime. While
+// disabled, every call is a no-op; while enabled, calls at or above its level
+// (see SetLevel) from an enabled component (see SetEnabledPattern) are
+// forwarded to the underlying Printer.
+type Jotter struct {
+	enabled   bool
+	level     Level
+	printer   Printer
+	formatter Formatter
+	fields    []interface{}
+	component string
+}
+
+// New creates a Jotter that writes to printer. The returned Jotter is
+// disabled by default; call Enable to start producing output. Events are
+// rendered with a TextFormatter until SetFormatter says otherwise.
+func New(printer Printer) *Jotter {
+	return &Jotter{printer: printer, formatter: TextFormatter{}}
+}
+
+// Enable output from this Jotter.
+func (j *Jotter) Enable() {
+	j.enabled = true
+}
+
+// Disable output from this Jotter.
+func (j *Jotter) Disable() {
+	j.enabled = false
+}
+
+// Print via this Jotter.
+// Arguments are handled in the manner of fmt.Print.
+func (j *Jotter) Print(v ...interface{}) {
+	j.emit(LevelInfo, fmt.Sprint(v...))
+}
+
+// Printf via this Jotter.
+// Arguments are handled in the manner of fmt.Printf.
+func (j *Jotter) Printf(format string, v ...interface{}) {
+	j.emit(LevelInfo, fmt.Sprintf(format, v...))
+}
+
+// Println via this Jotter.
+// Arguments are handled in the manner of fmt.Println.
+func (j *Jotter) Println(v ...interface{}) {
+	j.emit(LevelInfo, fmt.Sprintln(v...))
+}
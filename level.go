@@ -0,0 +1,205 @@
+package jot
+
+import "fmt"
+
+// Level represents the severity of a jotted message, from the noisiest
+// (LevelTrace) to the most severe (LevelError).
+type Level int
+
+// The severities a Jotter can filter and emit on.
+const (
+	LevelTrace Level = iota
+	LevelDebug
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// String returns the canonical name for the level, e.g. "DEBUG".
+func (l Level) String() string {
+	switch l {
+	case LevelTrace:
+		return "TRACE"
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// LevelPrinter is an optional extension of Printer for backends that want to
+// know the severity of a message, e.g. to map it onto syslog or GCP severity
+// levels. If a Jotter's Printer also implements LevelPrinter, every emitted
+// event - including those from Print and Printw, at LevelInfo - goes to
+// PrintLevel with its level instead of to Print.
+type LevelPrinter interface {
+	Printer
+	PrintLevel(level Level, v ...interface{})
+	PrintfLevel(level Level, format string, v ...interface{})
+}
+
+// SetLevel sets the minimum severity this Jotter will emit. Calls below the
+// configured level are skipped, even while enabled.
+func (j *Jotter) SetLevel(level Level) {
+	j.level = level
+}
+
+// GetLevel returns the minimum severity this Jotter will emit.
+func (j *Jotter) GetLevel() Level {
+	return j.level
+}
+
+// V reports whether a message at level would currently be emitted, letting
+// callers skip the cost of constructing expensive arguments:
+//
+//	if j.V(jot.LevelDebug) {
+//		j.Debug(expensiveDebugInfo())
+//	}
+//
+// This also accounts for component filtering set via SetEnabledPattern, so
+// a disabled component is just as cheap to skip as a disabled level.
+func (j *Jotter) V(level Level) bool {
+	return j.enabled && level >= j.level && componentEnabled(j.component)
+}
+
+// Trace writes v at LevelTrace.
+// Arguments are handled in the manner of fmt.Print.
+func (j *Jotter) Trace(v ...interface{}) {
+	j.emit(LevelTrace, fmt.Sprint(v...))
+}
+
+// Tracef writes v at LevelTrace.
+// Arguments are handled in the manner of fmt.Printf.
+func (j *Jotter) Tracef(format string, v ...interface{}) {
+	j.emit(LevelTrace, fmt.Sprintf(format, v...))
+}
+
+// Debug writes v at LevelDebug.
+// Arguments are handled in the manner of fmt.Print.
+func (j *Jotter) Debug(v ...interface{}) {
+	j.emit(LevelDebug, fmt.Sprint(v...))
+}
+
+// Debugf writes v at LevelDebug.
+// Arguments are handled in the manner of fmt.Printf.
+func (j *Jotter) Debugf(format string, v ...interface{}) {
+	j.emit(LevelDebug, fmt.Sprintf(format, v...))
+}
+
+// Info writes v at LevelInfo.
+// Arguments are handled in the manner of fmt.Print.
+func (j *Jotter) Info(v ...interface{}) {
+	j.emit(LevelInfo, fmt.Sprint(v...))
+}
+
+// Infof writes v at LevelInfo.
+// Arguments are handled in the manner of fmt.Printf.
+func (j *Jotter) Infof(format string, v ...interface{}) {
+	j.emit(LevelInfo, fmt.Sprintf(format, v...))
+}
+
+// Warn writes v at LevelWarn.
+// Arguments are handled in the manner of fmt.Print.
+func (j *Jotter) Warn(v ...interface{}) {
+	j.emit(LevelWarn, fmt.Sprint(v...))
+}
+
+// Warnf writes v at LevelWarn.
+// Arguments are handled in the manner of fmt.Printf.
+func (j *Jotter) Warnf(format string, v ...interface{}) {
+	j.emit(LevelWarn, fmt.Sprintf(format, v...))
+}
+
+// Error writes v at LevelError.
+// Arguments are handled in the manner of fmt.Print.
+func (j *Jotter) Error(v ...interface{}) {
+	j.emit(LevelError, fmt.Sprint(v...))
+}
+
+// Errorf writes v at LevelError.
+// Arguments are handled in the manner of fmt.Printf.
+func (j *Jotter) Errorf(format string, v ...interface{}) {
+	j.emit(LevelError, fmt.Sprintf(format, v...))
+}
+
+// SetLevel sets the minimum severity the standard Jotter will emit.
+func SetLevel(level Level) {
+	jotter.SetLevel(level)
+}
+
+// GetLevel returns the minimum severity the standard Jotter will emit.
+func GetLevel() Level {
+	return jotter.GetLevel()
+}
+
+// V reports whether a message at level would currently be emitted by the
+// standard Jotter.
+func V(level Level) bool {
+	return jotter.V(level)
+}
+
+// Trace via the standard Jotter.
+// Arguments are handled in the manner of fmt.Print.
+func Trace(v ...interface{}) {
+	jotter.Trace(v...)
+}
+
+// Tracef via the standard Jotter.
+// Arguments are handled in the manner of fmt.Printf.
+func Tracef(format string, v ...interface{}) {
+	jotter.Tracef(format, v...)
+}
+
+// Debug via the standard Jotter.
+// Arguments are handled in the manner of fmt.Print.
+func Debug(v ...interface{}) {
+	jotter.Debug(v...)
+}
+
+// Debugf via the standard Jotter.
+// Arguments are handled in the manner of fmt.Printf.
+func Debugf(format string, v ...interface{}) {
+	jotter.Debugf(format, v...)
+}
+
+// Info via the standard Jotter.
+// Arguments are handled in the manner of fmt.Print.
+func Info(v ...interface{}) {
+	jotter.Info(v...)
+}
+
+// Infof via the standard Jotter.
+// Arguments are handled in the manner of fmt.Printf.
+func Infof(format string, v ...interface{}) {
+	jotter.Infof(format, v...)
+}
+
+// Warn via the standard Jotter.
+// Arguments are handled in the manner of fmt.Print.
+func Warn(v ...interface{}) {
+	jotter.Warn(v...)
+}
+
+// Warnf via the standard Jotter.
+// Arguments are handled in the manner of fmt.Printf.
+func Warnf(format string, v ...interface{}) {
+	jotter.Warnf(format, v...)
+}
+
+// Error via the standard Jotter.
+// Arguments are handled in the manner of fmt.Print.
+func Error(v ...interface{}) {
+	jotter.Error(v...)
+}
+
+// Errorf via the standard Jotter.
+// Arguments are handled in the manner of fmt.Printf.
+func Errorf(format string, v ...interface{}) {
+	jotter.Errorf(format, v...)
+}
@@ -0,0 +1,114 @@
+package jot
+
+import "testing"
+
+func TestLevelDefaultsToTraceSoPreExistingCallersAreUnaffected(t *testing.T) {
+	c := &captorPrinter{}
+	j := New(c)
+
+	if got := j.GetLevel(); got != LevelTrace {
+		t.Fatalf("GetLevel() on a fresh Jotter = %v, want %v", got, LevelTrace)
+	}
+
+	j.Enable()
+	j.Print("hello")
+	if len(c.lines) != 1 {
+		t.Fatalf("got %d lines, want 1: a zero-value level must not filter unleveled Print calls", len(c.lines))
+	}
+}
+
+func TestSetLevelFiltersBelowConfiguredLevel(t *testing.T) {
+	c := &captorPrinter{}
+	j := New(c)
+	j.Enable()
+	j.SetLevel(LevelWarn)
+
+	if got := j.GetLevel(); got != LevelWarn {
+		t.Fatalf("GetLevel() = %v, want %v", got, LevelWarn)
+	}
+
+	j.Debug("should be skipped")
+	if len(c.lines) != 0 {
+		t.Errorf("got %d lines after Debug below the configured level, want 0", len(c.lines))
+	}
+
+	j.Warn("should be emitted")
+	if len(c.lines) != 1 {
+		t.Fatalf("got %d lines after Warn at the configured level, want 1", len(c.lines))
+	}
+	if c.lines[0] != "should be emitted" {
+		t.Errorf("line = %q, want %q", c.lines[0], "should be emitted")
+	}
+}
+
+func TestDisableSilencesEvenTheHighestLevel(t *testing.T) {
+	c := &captorPrinter{}
+	j := New(c)
+	j.SetLevel(LevelError)
+	j.Enable()
+
+	j.Error("emitted while enabled")
+	if len(c.lines) != 1 {
+		t.Fatalf("got %d lines while enabled, want 1", len(c.lines))
+	}
+
+	j.Disable()
+	j.Error("should be silenced")
+	if len(c.lines) != 1 {
+		t.Errorf("got %d lines after Disable, want still 1 (Disable must silence every level)", len(c.lines))
+	}
+}
+
+func TestVReflectsEnabledLevelAndComponentGating(t *testing.T) {
+	defer SetEnabledPattern("")
+
+	j := New(&captorPrinter{})
+
+	if j.V(LevelTrace) {
+		t.Error("V() = true before Enable, want false")
+	}
+
+	j.Enable()
+	j.SetLevel(LevelInfo)
+
+	tests := []struct {
+		level Level
+		want  bool
+	}{
+		{LevelTrace, false},
+		{LevelDebug, false},
+		{LevelInfo, true},
+		{LevelWarn, true},
+		{LevelError, true},
+	}
+	for _, tt := range tests {
+		if got := j.V(tt.level); got != tt.want {
+			t.Errorf("V(%v) = %v, want %v", tt.level, got, tt.want)
+		}
+	}
+
+	named := j.Named("worker")
+	SetEnabledPattern("server:*")
+	if named.V(LevelInfo) {
+		t.Error("V() = true for a component excluded by SetEnabledPattern, want false")
+	}
+}
+
+func TestLevelString(t *testing.T) {
+	tests := []struct {
+		level Level
+		want  string
+	}{
+		{LevelTrace, "TRACE"},
+		{LevelDebug, "DEBUG"},
+		{LevelInfo, "INFO"},
+		{LevelWarn, "WARN"},
+		{LevelError, "ERROR"},
+		{Level(99), "UNKNOWN"},
+	}
+	for _, tt := range tests {
+		if got := tt.level.String(); got != tt.want {
+			t.Errorf("Level(%d).String() = %q, want %q", tt.level, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package jot
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fallbackToStderr is used by the rotating file printers when a rotation
+// or write fails: the event still gets out, rather than being dropped
+// silently, and the error is noted alongside it.
+func fallbackToStderr(line string, err error) {
+	fmt.Fprintf(os.Stderr, "jot: %v\n", err)
+	if !strings.HasSuffix(line, "\n") {
+		line += "\n"
+	}
+	fmt.Fprint(os.Stderr, line)
+}
+
+// FixedSizeFilePrinter is a Printer that writes to a file at Path, rotating
+// it to Path.1, Path.2, ... once it would grow beyond MaxSize bytes, and
+// keeping at most MaxCount rotated files. It is safe for concurrent use.
+// A zero MaxCount disables rotation; the file is truncated and reopened
+// instead.
+type FixedSizeFilePrinter struct {
+	Path     string
+	MaxSize  int64
+	MaxCount int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// Print implements Printer.
+func (p *FixedSizeFilePrinter) Print(v ...interface{}) {
+	p.write(fmt.Sprint(v...))
+}
+
+// Printf implements Printer.
+func (p *FixedSizeFilePrinter) Printf(format string, v ...interface{}) {
+	p.write(fmt.Sprintf(format, v...))
+}
+
+// Println implements Printer.
+func (p *FixedSizeFilePrinter) Println(v ...interface{}) {
+	p.write(fmt.Sprintln(v...))
+}
+
+func (p *FixedSizeFilePrinter) write(line string) {
+	if !strings.HasSuffix(line, "\n") {
+		line += "\n"
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.file == nil {
+		if err := p.openLocked(); err != nil {
+			fallbackToStderr(line, err)
+			return
+		}
+	}
+	if p.MaxSize > 0 && p.size+int64(len(line)) > p.MaxSize {
+		if err := p.rotateLocked(); err != nil {
+			fallbackToStderr(line, err)
+			return
+		}
+	}
+
+	n, err := p.file.WriteString(line)
+	p.size += int64(n)
+	if err != nil {
+		fallbackToStderr(line, err)
+	}
+}
+
+func (p *FixedSizeFilePrinter) openLocked() error {
+	f, err := os.OpenFile(p.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	p.file = f
+	p.size = info.Size()
+	return nil
+}
+
+func (p *FixedSizeFilePrinter) rotateLocked() error {
+	if p.file != nil {
+		p.file.Close()
+		p.file = nil
+	}
+	if p.MaxCount > 0 {
+		os.Remove(fmt.Sprintf("%s.%d", p.Path, p.MaxCount))
+		for i := p.MaxCount - 1; i >= 1; i-- {
+			os.Rename(fmt.Sprintf("%s.%d", p.Path, i), fmt.Sprintf("%s.%d", p.Path, i+1))
+		}
+		os.Rename(p.Path, fmt.Sprintf("%s.1", p.Path))
+	} else {
+		os.Remove(p.Path)
+	}
+	return p.openLocked()
+}
+
+// DailyFilePrinter is a Printer that writes to a file at Path, suffixed
+// with the current local date (Path.YYYYMMDD), rolling over to a new file
+// at local midnight. It is safe for concurrent use.
+type DailyFilePrinter struct {
+	Path string
+
+	mu   sync.Mutex
+	file *os.File
+	day  string
+}
+
+// Print implements Printer.
+func (p *DailyFilePrinter) Print(v ...interface{}) {
+	p.write(fmt.Sprint(v...))
+}
+
+// Printf implements Printer.
+func (p *DailyFilePrinter) Printf(format string, v ...interface{}) {
+	p.write(fmt.Sprintf(format, v...))
+}
+
+// Println implements Printer.
+func (p *DailyFilePrinter) Println(v ...interface{}) {
+	p.write(fmt.Sprintln(v...))
+}
+
+func (p *DailyFilePrinter) write(line string) {
+	if !strings.HasSuffix(line, "\n") {
+		line += "\n"
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	today := time.Now().Format("20060102")
+	if p.file == nil || today != p.day {
+		if err := p.rollLocked(today); err != nil {
+			fallbackToStderr(line, err)
+			return
+		}
+	}
+	if _, err := p.file.WriteString(line); err != nil {
+		fallbackToStderr(line, err)
+	}
+}
+
+func (p *DailyFilePrinter) rollLocked(day string) error {
+	if p.file != nil {
+		p.file.Close()
+		p.file = nil
+	}
+	f, err := os.OpenFile(p.Path+"."+day, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	p.file = f
+	p.day = day
+	return nil
+}
@@ -0,0 +1,100 @@
+package jot
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestFixedSizeFilePrinterRotatesBySize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	p := &FixedSizeFilePrinter{Path: path, MaxSize: 20, MaxCount: 2}
+
+	lines := []string{"first-line", "second-line", "third-line", "fourth-line", "fifth-line"}
+	for _, line := range lines {
+		p.Println(line)
+	}
+
+	for i, suffix := range []string{"", ".1", ".2"} {
+		info, err := os.Stat(path + suffix)
+		if err != nil {
+			t.Fatalf("expected %s to exist: %v", path+suffix, err)
+		}
+		if info.Size() == 0 {
+			t.Errorf("%s is empty, want rotated content (entry %d)", path+suffix, i)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("expected %s.3 to be pruned by MaxCount, stat err = %v", path, err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile(%s): %v", path, err)
+	}
+	if !strings.Contains(string(got), "fifth-line") {
+		t.Errorf("current file = %q, want it to contain the most recent line", got)
+	}
+}
+
+func TestFixedSizeFilePrinterNoRotationWithoutMaxCountTruncates(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	p := &FixedSizeFilePrinter{Path: path, MaxSize: 10}
+
+	p.Println("first-line-is-long-enough-to-trigger-rotation")
+	p.Println("second-line-is-long-enough-to-trigger-rotation")
+
+	if _, err := os.Stat(path + ".1"); !os.IsNotExist(err) {
+		t.Errorf("expected no rotated file when MaxCount is 0, stat err = %v", err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile(%s): %v", path, err)
+	}
+	if strings.Contains(string(got), "first-line") {
+		t.Errorf("current file = %q, want the old content truncated away", got)
+	}
+}
+
+func TestFixedSizeFilePrinterConcurrentPrintIsSafe(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "concurrent.log")
+	p := &FixedSizeFilePrinter{Path: path, MaxSize: 200, MaxCount: 3}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			p.Printf("line %d\n", i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestDailyFilePrinterWritesToDateSuffixedFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "daily.log")
+	p := &DailyFilePrinter{Path: path}
+
+	p.Println("hello")
+
+	matches, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatalf("Glob: %v", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("got %d files matching %s.*, want 1", len(matches), path)
+	}
+	got, err := os.ReadFile(matches[0])
+	if err != nil {
+		t.Fatalf("ReadFile(%s): %v", matches[0], err)
+	}
+	if !strings.Contains(string(got), "hello") {
+		t.Errorf("file content = %q, want it to contain %q", got, "hello")
+	}
+}
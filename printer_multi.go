@@ -0,0 +1,59 @@
+package jot
+
+import (
+	"io"
+	"log"
+)
+
+// MultiPrinter returns a Printer that fans every call out to each of
+// printers in turn. Destinations are expected to be individually safe for
+// concurrent use, as *log.Logger already is, so MultiPrinter itself needs
+// no extra locking.
+func MultiPrinter(printers ...Printer) Printer {
+	return multiPrinter(printers)
+}
+
+type multiPrinter []Printer
+
+// Print implements Printer.
+func (m multiPrinter) Print(v ...interface{}) {
+	for _, p := range m {
+		p.Print(v...)
+	}
+}
+
+// Printf implements Printer.
+func (m multiPrinter) Printf(format string, v ...interface{}) {
+	for _, p := range m {
+		p.Printf(format, v...)
+	}
+}
+
+// Println implements Printer.
+func (m multiPrinter) Println(v ...interface{}) {
+	for _, p := range m {
+		p.Println(v...)
+	}
+}
+
+// loggersFor wraps each writer in a *log.Logger using standard flags.
+func loggersFor(writers []io.Writer) []Printer {
+	printers := make([]Printer, len(writers))
+	for i, w := range writers {
+		printers[i] = log.New(w, "", log.LstdFlags)
+	}
+	return printers
+}
+
+// SetWriters wraps each writer in a *log.Logger and sets the result as
+// this Jotter's Printer via MultiPrinter - a shortcut for fanning output
+// out to several io.Writer destinations at once.
+func (j *Jotter) SetWriters(writers ...io.Writer) {
+	j.printer = MultiPrinter(loggersFor(writers)...)
+}
+
+// SetWriters wraps each writer in a *log.Logger and sets the result as the
+// standard Jotter's Printer.
+func SetWriters(writers ...io.Writer) {
+	jotter.SetWriters(writers...)
+}
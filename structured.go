@@ -0,0 +1,47 @@
+package jot
+
+// With returns a child Jotter carrying keyvals as persistent structured
+// fields, merged after any fields already bound on the parent. The child
+// starts with a copy of its parent's enabled state, level, printer,
+// formatter and component name; later changes to either Jotter (Enable,
+// SetLevel, SetFormatter, further With calls) do not reach the other.
+func (j *Jotter) With(keyvals ...interface{}) *Jotter {
+	fields := make([]interface{}, 0, len(j.fields)+len(keyvals))
+	fields = append(fields, j.fields...)
+	fields = append(fields, toFieldPairs(keyvals)...)
+	return &Jotter{
+		enabled:   j.enabled,
+		level:     j.level,
+		printer:   j.printer,
+		formatter: j.formatter,
+		fields:    fields,
+		component: j.component,
+	}
+}
+
+// Printw writes a single structured event at LevelInfo: msg plus keyvals,
+// merged with any fields bound by With. keyvals are read as alternating
+// key, value pairs; an odd-length keyvals has a trailing "!BADKEY" entry
+// appended rather than panicking.
+func (j *Jotter) Printw(msg string, keyvals ...interface{}) {
+	j.emit(LevelInfo, msg, toFieldPairs(keyvals)...)
+}
+
+// toFieldPairs ensures keyvals has an even length so it can safely be read
+// as alternating key, value pairs.
+func toFieldPairs(keyvals []interface{}) []interface{} {
+	if len(keyvals)%2 == 0 {
+		return keyvals
+	}
+	return append(keyvals, "!BADKEY")
+}
+
+// With via the standard Jotter.
+func With(keyvals ...interface{}) *Jotter {
+	return jotter.With(keyvals...)
+}
+
+// Printw via the standard Jotter.
+func Printw(msg string, keyvals ...interface{}) {
+	jotter.Printw(msg, keyvals...)
+}
@@ -0,0 +1,61 @@
+package jot
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// captorPrinter records every line handed to it, so tests can assert on
+// the final rendered output of a Jotter.
+type captorPrinter struct {
+	lines []string
+}
+
+func (c *captorPrinter) Print(v ...interface{}) {
+	c.lines = append(c.lines, fmt.Sprint(v...))
+}
+
+func (c *captorPrinter) Printf(format string, v ...interface{}) {
+	c.lines = append(c.lines, fmt.Sprintf(format, v...))
+}
+
+func (c *captorPrinter) Println(v ...interface{}) {
+	c.lines = append(c.lines, fmt.Sprintln(v...))
+}
+
+func TestToFieldPairsAppendsBadKeySentinelOnOddLength(t *testing.T) {
+	got := toFieldPairs([]interface{}{"a", 1, "lonely"})
+	want := []interface{}{"a", 1, "lonely", "!BADKEY"}
+
+	if len(got) != len(want) {
+		t.Fatalf("toFieldPairs() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("toFieldPairs()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestToFieldPairsLeavesEvenLengthUnchanged(t *testing.T) {
+	got := toFieldPairs([]interface{}{"a", 1})
+	if len(got) != 2 {
+		t.Fatalf("toFieldPairs() = %v, want unchanged 2-element slice", got)
+	}
+}
+
+func TestPrintwOddKeyvalsEmitsBadKeySentinel(t *testing.T) {
+	c := &captorPrinter{}
+	j := New(c)
+	j.Enable()
+
+	j.Printw("event", "lonely")
+
+	if len(c.lines) != 1 {
+		t.Fatalf("got %d lines, want 1", len(c.lines))
+	}
+	if !strings.Contains(c.lines[0], "lonely=!BADKEY") {
+		t.Errorf("line = %q, want it to contain %q", c.lines[0], "lonely=!BADKEY")
+	}
+}